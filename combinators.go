@@ -0,0 +1,95 @@
+// Package invariant provides types for handling guaranteed non-nil values
+package invariant
+
+// Pair holds two values of potentially different types. It's the return
+// type of Zip, analogous to a 2-tuple.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// MapResult applies f to the contained value of r, converting it from T to
+// U. If r is Err, the error is passed through unchanged. This is a
+// top-level function (rather than a method on Result) because Go methods
+// can't introduce new type parameters beyond the receiver's, and Result's
+// own Map is constrained to T -> T for that reason.
+func MapResult[T, U any, E error](r Result[T, E], f func(T) U) Result[U, E] {
+	if r.isOk {
+		return Ok[U, E](f(*r.ok))
+	}
+	return Err[U, E](r.err)
+}
+
+// AndThen applies f to the contained value of r and returns its result. If r
+// is Err, the error is passed through unchanged. It's the flatMap
+// equivalent for Result, and lets T change to U across the chain.
+func AndThen[T, U any, E error](r Result[T, E], f func(T) Result[U, E]) Result[U, E] {
+	if r.isOk {
+		return f(*r.ok)
+	}
+	return Err[U, E](r.err)
+}
+
+// OrElse applies f to the contained error of r and returns its result,
+// converting the error type from E1 to E2. If r is Ok, the value is passed
+// through unchanged.
+func OrElse[T any, E1, E2 error](r Result[T, E1], f func(E1) Result[T, E2]) Result[T, E2] {
+	if r.isOk {
+		return Ok[T, E2](*r.ok)
+	}
+	return f(r.err)
+}
+
+// Zip combines two Results into a Result of a Pair, succeeding only if both
+// ra and rb are Ok. If either is Err, the first error encountered (ra's,
+// then rb's) is returned.
+func Zip[A, B any, E error](ra Result[A, E], rb Result[B, E]) Result[Pair[A, B], E] {
+	if !ra.isOk {
+		return Err[Pair[A, B], E](ra.err)
+	}
+	if !rb.isOk {
+		return Err[Pair[A, B], E](rb.err)
+	}
+	return Ok[Pair[A, B], E](Pair[A, B]{First: *ra.ok, Second: *rb.ok})
+}
+
+// Collect gathers a slice of Results into a single Result containing a
+// slice of the Ok values, short-circuiting on the first Err encountered.
+// It's patterned after Rust's Iterator::collect::<Result<Vec<_>,_>>().
+//
+// This is a deliberate, documented downgrade from collecting an
+// iter.Seq[Result[T, E]]: that would preserve laziness over the input
+// sequence, but iter.Seq requires Go 1.23 and this module's go.mod still
+// pins go 1.21. Collect over []Result[T, E] still short-circuits on the
+// first Err without evaluating the remaining elements, it just requires
+// the slice to already be materialized. Revisit once the floor moves to
+// 1.23.
+func Collect[T any, E error](results []Result[T, E]) Result[[]T, E] {
+	values := make([]T, 0, len(results))
+	for _, r := range results {
+		if !r.isOk {
+			return Err[[]T, E](r.err)
+		}
+		values = append(values, *r.ok)
+	}
+	return Ok[[]T, E](values)
+}
+
+// MapOption applies f to the contained value of o, converting it from T to
+// U. If o is None, None is returned.
+func MapOption[T, U any](o Option[T], f func(T) U) Option[U] {
+	if o.isSet {
+		return Some(f(*o.value))
+	}
+	return None[U]()
+}
+
+// AndThenOption applies f to the contained value of o and returns its
+// result. If o is None, None is returned. It's the flatMap equivalent for
+// Option, and lets T change to U across the chain.
+func AndThenOption[T, U any](o Option[T], f func(T) Option[U]) Option[U] {
+	if o.isSet {
+		return f(*o.value)
+	}
+	return None[U]()
+}
@@ -0,0 +1,207 @@
+package invariant_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/andamound/invariant"
+)
+
+func TestMapResult(t *testing.T) {
+	t.Run("Ok changes the value type", func(t *testing.T) {
+		result := invariant.Ok[string, error]("42")
+		mapped := invariant.MapResult[string, int, error](result, func(s string) int {
+			n, _ := strconv.Atoi(s)
+			return n
+		})
+		if mapped.Unwrap() != 42 {
+			t.Errorf("Expected Unwrap() to return 42, got '%v'", mapped.Unwrap())
+		}
+	})
+
+	t.Run("Err passes the error through unchanged", func(t *testing.T) {
+		testErr := errors.New("parse failed")
+		result := invariant.Err[string, error](testErr)
+		mapped := invariant.MapResult[string, int, error](result, func(s string) int {
+			n, _ := strconv.Atoi(s)
+			return n
+		})
+		if mapped.UnwrapErr() != testErr {
+			t.Errorf("Expected UnwrapErr() to return the original error, got '%v'", mapped.UnwrapErr())
+		}
+	})
+}
+
+func TestAndThen(t *testing.T) {
+	parse := func(s string) invariant.Result[int, error] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return invariant.Err[int, error](err)
+		}
+		return invariant.Ok[int, error](n)
+	}
+
+	t.Run("Ok chains into a new Result type", func(t *testing.T) {
+		result := invariant.Ok[string, error]("42")
+		chained := invariant.AndThen[string, int, error](result, parse)
+		if chained.Unwrap() != 42 {
+			t.Errorf("Expected Unwrap() to return 42, got '%v'", chained.Unwrap())
+		}
+	})
+
+	t.Run("Err short-circuits without calling f", func(t *testing.T) {
+		testErr := errors.New("upstream failure")
+		result := invariant.Err[string, error](testErr)
+		chained := invariant.AndThen[string, int, error](result, parse)
+		if chained.UnwrapErr() != testErr {
+			t.Errorf("Expected UnwrapErr() to return the original error, got '%v'", chained.UnwrapErr())
+		}
+	})
+}
+
+type customErr struct{ msg string }
+
+func (e *customErr) Error() string { return e.msg }
+
+func TestOrElse(t *testing.T) {
+	t.Run("Ok passes the value through unchanged", func(t *testing.T) {
+		result := invariant.Ok[int, error](42)
+		recovered := invariant.OrElse[int, error, *customErr](result, func(err error) invariant.Result[int, *customErr] {
+			return invariant.Err[int, *customErr](&customErr{msg: err.Error()})
+		})
+		if recovered.Unwrap() != 42 {
+			t.Errorf("Expected Unwrap() to return 42, got '%v'", recovered.Unwrap())
+		}
+	})
+
+	t.Run("Err converts the error type via f", func(t *testing.T) {
+		result := invariant.Err[int, error](errors.New("boom"))
+		recovered := invariant.OrElse[int, error, *customErr](result, func(err error) invariant.Result[int, *customErr] {
+			return invariant.Err[int, *customErr](&customErr{msg: err.Error()})
+		})
+		if recovered.UnwrapErr().Error() != "boom" {
+			t.Errorf("Expected UnwrapErr().Error() to return 'boom', got '%v'", recovered.UnwrapErr().Error())
+		}
+	})
+}
+
+func TestZip(t *testing.T) {
+	t.Run("Both Ok combines into a Pair", func(t *testing.T) {
+		ra := invariant.Ok[string, error]("name")
+		rb := invariant.Ok[int, error](42)
+		zipped := invariant.Zip[string, int, error](ra, rb)
+
+		pair := zipped.Unwrap()
+		if pair.First != "name" || pair.Second != 42 {
+			t.Errorf("Expected Pair{name, 42}, got %+v", pair)
+		}
+	})
+
+	t.Run("First Err wins", func(t *testing.T) {
+		firstErr := errors.New("first failed")
+		ra := invariant.Err[string, error](firstErr)
+		rb := invariant.Err[int, error](errors.New("second failed"))
+		zipped := invariant.Zip[string, int, error](ra, rb)
+
+		if zipped.UnwrapErr() != firstErr {
+			t.Errorf("Expected UnwrapErr() to return the first error, got '%v'", zipped.UnwrapErr())
+		}
+	})
+
+	t.Run("Second Err is returned if only it fails", func(t *testing.T) {
+		secondErr := errors.New("second failed")
+		ra := invariant.Ok[string, error]("name")
+		rb := invariant.Err[int, error](secondErr)
+		zipped := invariant.Zip[string, int, error](ra, rb)
+
+		if zipped.UnwrapErr() != secondErr {
+			t.Errorf("Expected UnwrapErr() to return the second error, got '%v'", zipped.UnwrapErr())
+		}
+	})
+}
+
+func TestCollect(t *testing.T) {
+	t.Run("All Ok collects into a slice", func(t *testing.T) {
+		results := []invariant.Result[int, error]{
+			invariant.Ok[int, error](1),
+			invariant.Ok[int, error](2),
+			invariant.Ok[int, error](3),
+		}
+		collected := invariant.Collect(results)
+		values := collected.Unwrap()
+		if len(values) != 3 || values[0] != 1 || values[1] != 2 || values[2] != 3 {
+			t.Errorf("Expected [1 2 3], got %v", values)
+		}
+	})
+
+	t.Run("First Err short-circuits", func(t *testing.T) {
+		testErr := errors.New("bad value")
+		results := []invariant.Result[int, error]{
+			invariant.Ok[int, error](1),
+			invariant.Err[int, error](testErr),
+			invariant.Ok[int, error](3),
+		}
+		collected := invariant.Collect(results)
+		if collected.UnwrapErr() != testErr {
+			t.Errorf("Expected UnwrapErr() to return the original error, got '%v'", collected.UnwrapErr())
+		}
+	})
+
+	t.Run("Empty slice collects to an empty slice", func(t *testing.T) {
+		collected := invariant.Collect([]invariant.Result[int, error]{})
+		if len(collected.Unwrap()) != 0 {
+			t.Errorf("Expected an empty slice, got %v", collected.Unwrap())
+		}
+	})
+}
+
+func TestMapOption(t *testing.T) {
+	t.Run("Some changes the value type", func(t *testing.T) {
+		option := invariant.Some("42")
+		mapped := invariant.MapOption(option, func(s string) int {
+			n, _ := strconv.Atoi(s)
+			return n
+		})
+		if mapped.Unwrap() != 42 {
+			t.Errorf("Expected Unwrap() to return 42, got '%v'", mapped.Unwrap())
+		}
+	})
+
+	t.Run("None stays None", func(t *testing.T) {
+		option := invariant.None[string]()
+		mapped := invariant.MapOption(option, func(s string) int {
+			n, _ := strconv.Atoi(s)
+			return n
+		})
+		if mapped.IsSome() {
+			t.Error("Expected MapOption() on None to return None")
+		}
+	})
+}
+
+func TestAndThenOption(t *testing.T) {
+	parse := func(s string) invariant.Option[int] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return invariant.None[int]()
+		}
+		return invariant.Some(n)
+	}
+
+	t.Run("Some chains into a new Option type", func(t *testing.T) {
+		option := invariant.Some("42")
+		chained := invariant.AndThenOption(option, parse)
+		if chained.Unwrap() != 42 {
+			t.Errorf("Expected Unwrap() to return 42, got '%v'", chained.Unwrap())
+		}
+	})
+
+	t.Run("None short-circuits without calling f", func(t *testing.T) {
+		option := invariant.None[string]()
+		chained := invariant.AndThenOption(option, parse)
+		if chained.IsSome() {
+			t.Error("Expected AndThenOption() on None to return None")
+		}
+	})
+}
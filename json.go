@@ -0,0 +1,168 @@
+// Package invariant provides types for handling guaranteed non-nil values
+package invariant
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	errorDecodersMu sync.RWMutex
+	errorDecoders   = map[reflect.Type]any{}
+)
+
+// RegisterErrorDecoder registers a decoder for error type E so that
+// Result[T, E].UnmarshalJSON can reconstruct E from the JSON value stored
+// under the "err" key. Without a registered decoder, UnmarshalJSON can only
+// recover errors for results typed as the plain error interface, via
+// errors.New of the encoded string.
+func RegisterErrorDecoder[E error](decode func(json.RawMessage) (E, error)) {
+	var zero E
+	t := reflect.TypeOf(&zero).Elem()
+
+	errorDecodersMu.Lock()
+	defer errorDecodersMu.Unlock()
+	errorDecoders[t] = decode
+}
+
+// resultOkJSON is the wire format for an Ok Result: {"ok": <T>}.
+type resultOkJSON[T any] struct {
+	Ok T `json:"ok"`
+}
+
+// resultErrJSON is the wire format for an Err Result: {"err": <value>}.
+type resultErrJSON struct {
+	Err any `json:"err"`
+}
+
+// MarshalJSON implements json.Marshaler. An Ok result marshals as
+// {"ok": <T>}. An Err result marshals as {"err": <E>} when E is a concrete
+// error type (letting encoding/json marshal its fields, or call its own
+// MarshalJSON), or as {"err": "<message>"} when E is the plain error
+// interface, since its concrete value may carry unexported fields.
+func (r Result[T, E]) MarshalJSON() ([]byte, error) {
+	if r.isOk {
+		return json.Marshal(resultOkJSON[T]{Ok: *r.ok})
+	}
+	if isNilError(r.err) {
+		return nil, errors.New("invariant: cannot marshal a zero-value Result; construct it with Ok or Err")
+	}
+
+	var zero E
+	if reflect.TypeOf(&zero).Elem().Kind() == reflect.Interface {
+		return json.Marshal(resultErrJSON{Err: r.err.Error()})
+	}
+	return json.Marshal(resultErrJSON{Err: r.err})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the {"ok": <T>} /
+// {"err": <value>} wire format produced by MarshalJSON. Reconstructing a
+// non-interface E from the "err" value requires a decoder registered via
+// RegisterErrorDecoder.
+func (r *Result[T, E]) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Ok  json.RawMessage `json:"ok"`
+		Err json.RawMessage `json:"err"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch {
+	case raw.Ok != nil:
+		var value T
+		if err := json.Unmarshal(raw.Ok, &value); err != nil {
+			return err
+		}
+		if isNil(value) {
+			return fmt.Errorf("invariant: cannot unmarshal null into Result[%T, ...]: \"ok\" value must not be nil", value)
+		}
+		*r = Ok[T, E](value)
+		return nil
+	case raw.Err != nil:
+		errValue, err := decodeResultError[E](raw.Err)
+		if err != nil {
+			return err
+		}
+		*r = Err[T, E](errValue)
+		return nil
+	default:
+		return fmt.Errorf("invariant: invalid Result JSON: expected \"ok\" or \"err\" key")
+	}
+}
+
+// decodeResultError reconstructs an E from the raw JSON stored under the
+// "err" key, using a registered decoder when available and falling back to
+// errors.New(<string>) when E is the plain error interface.
+func decodeResultError[E error](raw json.RawMessage) (E, error) {
+	var zero E
+	t := reflect.TypeOf(&zero).Elem()
+
+	errorDecodersMu.RLock()
+	decodeAny, ok := errorDecoders[t]
+	errorDecodersMu.RUnlock()
+	if ok {
+		return decodeAny.(func(json.RawMessage) (E, error))(raw)
+	}
+
+	if t.Kind() == reflect.Interface {
+		var message string
+		if err := json.Unmarshal(raw, &message); err == nil {
+			if converted, ok := any(errors.New(message)).(E); ok {
+				return converted, nil
+			}
+		}
+	}
+
+	return zero, fmt.Errorf("invariant: no error decoder registered for %s; call RegisterErrorDecoder", t)
+}
+
+// MarshalJSON implements json.Marshaler, marshaling the underlying value.
+func (sp SafePointer[T]) MarshalJSON() ([]byte, error) {
+	if sp.ptr == nil {
+		return nil, errors.New("invariant: cannot marshal a zero-value SafePointer; construct it with NewSafePointer or SP")
+	}
+	return json.Marshal(*sp.ptr)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Panics if data is JSON null,
+// consistent with the constructor contract that a SafePointer never wraps a
+// nil value.
+func (sp *SafePointer[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		panic("invariant.SafePointer.UnmarshalJSON: nil value provided")
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	sp.ptr = &value
+	return nil
+}
+
+// StringResult is Result[string, error] as a defined (non-alias) type, so
+// that it can carry the encoding.TextMarshaler / encoding.TextUnmarshaler
+// methods Go's generics don't allow attaching to one particular
+// instantiation of Result itself.
+type StringResult Result[string, error]
+
+// MarshalText implements encoding.TextMarshaler, returning the Ok value's
+// bytes, or the contained error if the result is Err.
+func (sr StringResult) MarshalText() ([]byte, error) {
+	r := Result[string, error](sr)
+	if r.IsErr() {
+		return nil, r.UnwrapErr()
+	}
+	return []byte(r.Unwrap()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, always producing an Ok
+// result wrapping the decoded text.
+func (sr *StringResult) UnmarshalText(text []byte) error {
+	*sr = StringResult(Ok[string, error](string(text)))
+	return nil
+}
@@ -0,0 +1,189 @@
+package invariant_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/andamound/invariant"
+)
+
+func TestResultJSON(t *testing.T) {
+	t.Run("Ok round-trips through JSON", func(t *testing.T) {
+		result := invariant.Ok[int, error](42)
+
+		data, err := json.Marshal(result)
+		if err != nil {
+			t.Fatalf("Marshal() returned an error: %v", err)
+		}
+		if string(data) != `{"ok":42}` {
+			t.Errorf("Expected Marshal() to return '{\"ok\":42}', got '%s'", data)
+		}
+
+		var decoded invariant.Result[int, error]
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal() returned an error: %v", err)
+		}
+		if decoded.Unwrap() != 42 {
+			t.Errorf("Expected decoded Unwrap() to return 42, got '%v'", decoded.Unwrap())
+		}
+	})
+
+	t.Run("Err with a plain error marshals as a string message", func(t *testing.T) {
+		result := invariant.Err[int, error](errors.New("boom"))
+
+		data, err := json.Marshal(result)
+		if err != nil {
+			t.Fatalf("Marshal() returned an error: %v", err)
+		}
+		if string(data) != `{"err":"boom"}` {
+			t.Errorf("Expected Marshal() to return '{\"err\":\"boom\"}', got '%s'", data)
+		}
+
+		var decoded invariant.Result[int, error]
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal() returned an error: %v", err)
+		}
+		if decoded.UnwrapErr().Error() != "boom" {
+			t.Errorf("Expected decoded UnwrapErr() to return 'boom', got '%v'", decoded.UnwrapErr())
+		}
+	})
+
+	t.Run("Unmarshal rejects a null \"ok\" value instead of panicking", func(t *testing.T) {
+		type Foo struct{ Name string }
+
+		var decoded invariant.Result[*Foo, error]
+		err := json.Unmarshal([]byte(`{"ok": null}`), &decoded)
+		if err == nil {
+			t.Error("Expected Unmarshal() to return an error for a null \"ok\" value")
+		}
+	})
+
+	t.Run("Unmarshal rejects JSON missing both keys", func(t *testing.T) {
+		var decoded invariant.Result[int, error]
+		if err := json.Unmarshal([]byte(`{}`), &decoded); err == nil {
+			t.Error("Expected Unmarshal() to return an error for JSON missing both \"ok\" and \"err\"")
+		}
+	})
+
+	t.Run("Marshal rejects a zero-value Result instead of panicking", func(t *testing.T) {
+		var zero invariant.Result[int, error]
+		if _, err := json.Marshal(zero); err == nil {
+			t.Error("Expected Marshal() to return an error for a zero-value Result")
+		}
+	})
+}
+
+type jsonTestCode struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *jsonTestCode) Error() string {
+	return e.Message
+}
+
+func TestResultJSONWithRegisteredDecoder(t *testing.T) {
+	invariant.RegisterErrorDecoder(func(raw json.RawMessage) (*jsonTestCode, error) {
+		var decoded jsonTestCode
+		if err := json.Unmarshal(raw, &decoded); err != nil {
+			return nil, err
+		}
+		return &decoded, nil
+	})
+
+	result := invariant.Err[int, *jsonTestCode](&jsonTestCode{Code: 404, Message: "not found"})
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Marshal() returned an error: %v", err)
+	}
+	if string(data) != `{"err":{"code":404,"message":"not found"}}` {
+		t.Errorf("Expected Marshal() to return the JSON-marshaled error, got '%s'", data)
+	}
+
+	var decoded invariant.Result[int, *jsonTestCode]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() returned an error: %v", err)
+	}
+	if decoded.UnwrapErr().Code != 404 || decoded.UnwrapErr().Message != "not found" {
+		t.Errorf("Expected decoded error to round-trip, got %+v", decoded.UnwrapErr())
+	}
+}
+
+func TestSafePointerJSON(t *testing.T) {
+	t.Run("Marshals the underlying value", func(t *testing.T) {
+		value := 42
+		sp := invariant.NewSafePointer(&value)
+
+		data, err := json.Marshal(sp)
+		if err != nil {
+			t.Fatalf("Marshal() returned an error: %v", err)
+		}
+		if string(data) != `42` {
+			t.Errorf("Expected Marshal() to return '42', got '%s'", data)
+		}
+	})
+
+	t.Run("Unmarshal sets the underlying value", func(t *testing.T) {
+		var sp invariant.SafePointer[int]
+		if err := json.Unmarshal([]byte(`42`), &sp); err != nil {
+			t.Fatalf("Unmarshal() returned an error: %v", err)
+		}
+		if sp.Get() != 42 {
+			t.Errorf("Expected Get() to return 42, got '%v'", sp.Get())
+		}
+	})
+
+	t.Run("Unmarshal panics on JSON null", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected Unmarshal() to panic on JSON null")
+			}
+		}()
+
+		var sp invariant.SafePointer[int]
+		_ = json.Unmarshal([]byte(`null`), &sp) // This should panic
+	})
+
+	t.Run("Marshal rejects a zero-value SafePointer instead of panicking", func(t *testing.T) {
+		var sp invariant.SafePointer[int]
+		if _, err := json.Marshal(sp); err == nil {
+			t.Error("Expected Marshal() to return an error for a zero-value SafePointer")
+		}
+	})
+}
+
+func TestStringResultText(t *testing.T) {
+	t.Run("MarshalText returns the Ok value's bytes", func(t *testing.T) {
+		sr := invariant.StringResult(invariant.Ok[string, error]("hello"))
+
+		data, err := sr.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText() returned an error: %v", err)
+		}
+		if string(data) != "hello" {
+			t.Errorf("Expected MarshalText() to return 'hello', got '%s'", data)
+		}
+	})
+
+	t.Run("MarshalText returns the contained error on Err", func(t *testing.T) {
+		testErr := errors.New("boom")
+		sr := invariant.StringResult(invariant.Err[string, error](testErr))
+
+		if _, err := sr.MarshalText(); err != testErr {
+			t.Errorf("Expected MarshalText() to return the original error, got '%v'", err)
+		}
+	})
+
+	t.Run("UnmarshalText produces an Ok result", func(t *testing.T) {
+		var sr invariant.StringResult
+		if err := sr.UnmarshalText([]byte("hello")); err != nil {
+			t.Fatalf("UnmarshalText() returned an error: %v", err)
+		}
+		r := invariant.Result[string, error](sr)
+		if r.Unwrap() != "hello" {
+			t.Errorf("Expected Unwrap() to return 'hello', got '%v'", r.Unwrap())
+		}
+	})
+}
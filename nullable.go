@@ -0,0 +1,53 @@
+// Package invariant provides types for handling guaranteed non-nil values
+package invariant
+
+import "reflect"
+
+// Nullable lets a type report its own nilness, letting isNil and
+// isNilError skip reflection for types that implement it. It mirrors how
+// errors.Is/errors.As prefer interface satisfaction over reflection where
+// possible.
+type Nullable interface {
+	IsNil() bool
+}
+
+// isNil reports whether v is nil, for interface and pointer-like types. It
+// checks, in order of increasing cost: plain interface nilness (no
+// reflection), the Nullable interface (no reflection), then falls back to
+// reflection for pointer/interface/slice/map/chan/func kinds that reflect
+// alone can determine.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	if n, ok := v.(Nullable); ok {
+		return n.IsNil()
+	}
+
+	return reflectIsNil(v)
+}
+
+// isNilError reports whether err is nil, following the same fast-path
+// ordering as isNil.
+func isNilError(err error) bool {
+	if err == nil {
+		return true
+	}
+
+	if n, ok := err.(Nullable); ok {
+		return n.IsNil()
+	}
+
+	return reflectIsNil(err)
+}
+
+// reflectIsNil is the reflection-based fallback shared by isNil and
+// isNilError, used only once the cheaper interface checks have failed.
+func reflectIsNil(v any) bool {
+	val := reflect.ValueOf(v)
+	kind := val.Kind()
+	return (kind == reflect.Ptr || kind == reflect.Interface ||
+		kind == reflect.Slice || kind == reflect.Map || kind == reflect.Chan ||
+		kind == reflect.Func) && val.IsNil()
+}
@@ -0,0 +1,43 @@
+package invariant_test
+
+import (
+	"testing"
+
+	"github.com/andamound/invariant"
+)
+
+// BenchmarkOkPrimitive measures Ok's nil check on a primitive T, which can
+// never be nil, so any time spent here is pure overhead from the fallback
+// reflection path.
+func BenchmarkOkPrimitive(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = invariant.Ok[int, error](i)
+	}
+}
+
+// BenchmarkMustOkPrimitive is the same workload as BenchmarkOkPrimitive but
+// using MustOk, which skips the nil check entirely.
+func BenchmarkMustOkPrimitive(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = invariant.MustOk[int, error](i)
+	}
+}
+
+// BenchmarkOkNullable measures Ok's nil check on a type implementing
+// Nullable, which takes the interface fast path instead of reflection.
+func BenchmarkOkNullable(b *testing.B) {
+	box := &nullableBox{value: 42}
+	for i := 0; i < b.N; i++ {
+		_ = invariant.Ok[*nullableBox, error](box)
+	}
+}
+
+// BenchmarkOkPlainPointer measures Ok's nil check on a pointer type that
+// doesn't implement Nullable, which still falls back to reflection.
+func BenchmarkOkPlainPointer(b *testing.B) {
+	value := 42
+	ptr := &value
+	for i := 0; i < b.N; i++ {
+		_ = invariant.Ok[*int, error](ptr)
+	}
+}
@@ -0,0 +1,73 @@
+package invariant_test
+
+import (
+	"testing"
+
+	"github.com/andamound/invariant"
+)
+
+// nullableBox implements invariant.Nullable, letting isNil take the
+// interface fast path instead of falling back to reflection.
+type nullableBox struct {
+	value int
+	empty bool
+}
+
+func (b *nullableBox) IsNil() bool {
+	return b == nil || b.empty
+}
+
+func TestNullableFastPath(t *testing.T) {
+	t.Run("Ok accepts a non-nil Nullable", func(t *testing.T) {
+		box := &nullableBox{value: 42}
+		result := invariant.Ok[*nullableBox, error](box)
+		if result.Unwrap().value != 42 {
+			t.Errorf("Expected Unwrap().value to be 42, got '%v'", result.Unwrap().value)
+		}
+	})
+
+	t.Run("Ok panics on a Nullable reporting empty", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected Ok() to panic when the Nullable reports empty")
+			}
+		}()
+
+		box := &nullableBox{empty: true}
+		_ = invariant.Ok[*nullableBox, error](box) // This should panic
+	})
+}
+
+func TestMustOk(t *testing.T) {
+	t.Run("Skips the nil check and wraps the value", func(t *testing.T) {
+		result := invariant.MustOk[int, error](42)
+		if result.Unwrap() != 42 {
+			t.Errorf("Expected Unwrap() to return 42, got '%v'", result.Unwrap())
+		}
+
+		var nilPtr *string
+		if invariant.MustOk[*string, error](nilPtr).Unwrap() != nil {
+			t.Error("Expected MustOk() to wrap a nil pointer without panicking")
+		}
+	})
+}
+
+func TestTryOk(t *testing.T) {
+	t.Run("Non-nil value succeeds", func(t *testing.T) {
+		result, ok := invariant.TryOk[int, error](42)
+		if !ok {
+			t.Fatal("Expected TryOk() to report success for a non-nil value")
+		}
+		if result.Unwrap() != 42 {
+			t.Errorf("Expected Unwrap() to return 42, got '%v'", result.Unwrap())
+		}
+	})
+
+	t.Run("Nil pointer reports failure instead of panicking", func(t *testing.T) {
+		var nilPtr *string
+		_, ok := invariant.TryOk[*string, error](nilPtr)
+		if ok {
+			t.Error("Expected TryOk() to report failure for a nil pointer")
+		}
+	})
+}
@@ -0,0 +1,186 @@
+// Package invariant provides types for handling guaranteed non-nil values
+package invariant
+
+// Option represents a value that may or may not be present.
+// It's similar to Rust's Option type and ensures that the presence of a
+// value is tracked explicitly instead of relying on nil.
+type Option[T any] struct {
+	value *T
+	isSet bool
+}
+
+// Some creates a new Option containing a value.
+// Panics if the value is nil (for pointer or interface types).
+func Some[T any](value T) Option[T] {
+	if isNil(value) {
+		panic("invariant.Some: nil value provided")
+	}
+
+	return Option[T]{
+		value: &value,
+		isSet: true,
+	}
+}
+
+// None creates a new Option with no value.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// OptionFromPtr creates an Option from a pointer, returning None if the
+// pointer is nil and Some(*ptr) otherwise.
+func OptionFromPtr[T any](ptr *T) Option[T] {
+	if ptr == nil {
+		return None[T]()
+	}
+	return Some(*ptr)
+}
+
+// IsSome returns true if the Option contains a value.
+func (o Option[T]) IsSome() bool {
+	return o.isSet
+}
+
+// IsNone returns true if the Option contains no value.
+func (o Option[T]) IsNone() bool {
+	return !o.isSet
+}
+
+// Unwrap returns the contained value or panics if the Option is None.
+// It's similar to Rust's unwrap() method.
+func (o Option[T]) Unwrap() T {
+	if !o.isSet {
+		panic("invariant.Option.Unwrap: called on None value")
+	}
+	return *o.value
+}
+
+// UnwrapOr returns the contained value or the provided default value if the
+// Option is None.
+func (o Option[T]) UnwrapOr(defaultValue T) T {
+	if !o.isSet {
+		return defaultValue
+	}
+	return *o.value
+}
+
+// UnwrapOrElse returns the contained value or computes a default from f if
+// the Option is None.
+func (o Option[T]) UnwrapOrElse(f func() T) T {
+	if !o.isSet {
+		return f()
+	}
+	return *o.value
+}
+
+// Expect returns the contained value or panics with the provided message if
+// the Option is None.
+func (o Option[T]) Expect(msg string) T {
+	if !o.isSet {
+		panic(msg)
+	}
+	return *o.value
+}
+
+// Map applies a function to the contained value if the Option is Some,
+// otherwise returns None unchanged.
+func (o Option[T]) Map(f func(T) T) Option[T] {
+	if o.isSet {
+		return Some(f(*o.value))
+	}
+	return o
+}
+
+// AndThen applies f to the contained value if the Option is Some and returns
+// its result, otherwise returns None. It's the flatMap equivalent for Option.
+func (o Option[T]) AndThen(f func(T) Option[T]) Option[T] {
+	if o.isSet {
+		return f(*o.value)
+	}
+	return o
+}
+
+// Inspect calls f with the contained value if the Option is Some, then
+// returns the Option unchanged. It's useful for inserting logging, metrics,
+// or tracing calls into an existing chain without breaking it.
+func (o Option[T]) Inspect(f func(T)) Option[T] {
+	if o.isSet {
+		f(*o.value)
+	}
+	return o
+}
+
+// Or returns the Option if it contains a value, otherwise returns other.
+func (o Option[T]) Or(other Option[T]) Option[T] {
+	if o.isSet {
+		return o
+	}
+	return other
+}
+
+// Filter returns None if the Option is None, or if the predicate returns
+// false for the contained value. Otherwise returns the Option unchanged.
+func (o Option[T]) Filter(predicate func(T) bool) Option[T] {
+	if o.isSet && predicate(*o.value) {
+		return o
+	}
+	return None[T]()
+}
+
+// Take replaces the Option with None and returns the original Option.
+func (o *Option[T]) Take() Option[T] {
+	taken := *o
+	*o = None[T]()
+	return taken
+}
+
+// Match executes someFn if the Option is Some, or noneFn if the Option is
+// None. This provides a way to handle both cases with a single function
+// call.
+func (o Option[T]) Match(someFn func(T), noneFn func()) {
+	if o.isSet {
+		someFn(*o.value)
+	} else {
+		noneFn()
+	}
+}
+
+// OkOr converts the Option into a Result, using the provided error if the
+// Option is None.
+//
+// This is a top-level function rather than a method because Go methods
+// can't introduce new type parameters (E isn't part of Option[T]'s
+// parameter list).
+func OkOr[T any, E error](o Option[T], err E) Result[T, E] {
+	if o.isSet {
+		return Ok[T, E](*o.value)
+	}
+	return Err[T, E](err)
+}
+
+// OkOrElse converts the Option into a Result, computing the error from f if
+// the Option is None.
+func OkOrElse[T any, E error](o Option[T], f func() E) Result[T, E] {
+	if o.isSet {
+		return Ok[T, E](*o.value)
+	}
+	return Err[T, E](f())
+}
+
+// Ok converts the Result into an Option, discarding any error and returning
+// None if the Result is Err.
+func (r Result[T, E]) Ok() Option[T] {
+	if r.isOk {
+		return Some(*r.ok)
+	}
+	return None[T]()
+}
+
+// Err converts the Result into an Option, discarding the success value and
+// returning None if the Result is Ok.
+func (r Result[T, E]) Err() Option[E] {
+	if !r.isOk {
+		return Some(r.err)
+	}
+	return None[E]()
+}
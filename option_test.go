@@ -0,0 +1,259 @@
+package invariant_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andamound/invariant"
+)
+
+func TestOption(t *testing.T) {
+	// Test Some option
+	t.Run("Some option", func(t *testing.T) {
+		option := invariant.Some("success")
+
+		// Check state
+		if !option.IsSome() {
+			t.Error("Expected IsSome() to be true")
+		}
+		if option.IsNone() {
+			t.Error("Expected IsNone() to be false")
+		}
+
+		// Check Unwrap
+		if option.Unwrap() != "success" {
+			t.Errorf("Expected Unwrap() to return 'success', got '%v'", option.Unwrap())
+		}
+
+		// Check UnwrapOr
+		if option.UnwrapOr("default") != "success" {
+			t.Errorf("Expected UnwrapOr() to return 'success', got '%v'", option.UnwrapOr("default"))
+		}
+
+		// Check UnwrapOrElse
+		if option.UnwrapOrElse(func() string { return "default" }) != "success" {
+			t.Errorf("Expected UnwrapOrElse() to return 'success', got '%v'", option.UnwrapOrElse(func() string { return "default" }))
+		}
+
+		// Check Map
+		mapped := option.Map(func(s string) string { return s + "!" })
+		if mapped.Unwrap() != "success!" {
+			t.Errorf("Expected Map() to return 'success!', got '%v'", mapped.Unwrap())
+		}
+
+		// Check AndThen
+		chained := option.AndThen(func(s string) invariant.Option[string] {
+			return invariant.Some(s + " chained")
+		})
+		if chained.Unwrap() != "success chained" {
+			t.Errorf("Expected AndThen() to return 'success chained', got '%v'", chained.Unwrap())
+		}
+
+		// Check Filter
+		if option.Filter(func(s string) bool { return s == "success" }).IsNone() {
+			t.Error("Expected Filter() to keep the value when predicate is true")
+		}
+		if option.Filter(func(s string) bool { return s == "nope" }).IsSome() {
+			t.Error("Expected Filter() to discard the value when predicate is false")
+		}
+
+		// Check Or
+		if option.Or(invariant.Some("other")).Unwrap() != "success" {
+			t.Error("Expected Or() to return the original value when Some")
+		}
+
+		// Check Match
+		var matchResult string
+		option.Match(
+			func(s string) { matchResult = "Some: " + s },
+			func() { matchResult = "None" },
+		)
+		if matchResult != "Some: success" {
+			t.Errorf("Expected Match() to set matchResult to 'Some: success', got '%v'", matchResult)
+		}
+	})
+
+	// Test None option
+	t.Run("None option", func(t *testing.T) {
+		option := invariant.None[string]()
+
+		// Check state
+		if option.IsSome() {
+			t.Error("Expected IsSome() to be false")
+		}
+		if !option.IsNone() {
+			t.Error("Expected IsNone() to be true")
+		}
+
+		// Check UnwrapOr
+		if option.UnwrapOr("default") != "default" {
+			t.Errorf("Expected UnwrapOr() to return 'default', got '%v'", option.UnwrapOr("default"))
+		}
+
+		// Check UnwrapOrElse
+		if option.UnwrapOrElse(func() string { return "default" }) != "default" {
+			t.Errorf("Expected UnwrapOrElse() to return 'default', got '%v'", option.UnwrapOrElse(func() string { return "default" }))
+		}
+
+		// Check Or
+		if option.Or(invariant.Some("other")).Unwrap() != "other" {
+			t.Error("Expected Or() to return the other value when None")
+		}
+
+		// Check Match
+		var matchResult string
+		option.Match(
+			func(s string) { matchResult = "Some: " + s },
+			func() { matchResult = "None" },
+		)
+		if matchResult != "None" {
+			t.Errorf("Expected Match() to set matchResult to 'None', got '%v'", matchResult)
+		}
+	})
+
+	// Test Unwrap panic on None
+	t.Run("Unwrap panic on None", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected Unwrap() to panic on None value")
+			}
+		}()
+
+		option := invariant.None[int]()
+		_ = option.Unwrap() // This should panic
+	})
+
+	// Test Expect panic on None
+	t.Run("Expect panic on None", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected Expect() to panic on None value")
+			}
+		}()
+
+		option := invariant.None[int]()
+		_ = option.Expect("custom message") // This should panic
+	})
+
+	// Test Take
+	t.Run("Take", func(t *testing.T) {
+		option := invariant.Some(42)
+
+		taken := option.Take()
+		if taken.Unwrap() != 42 {
+			t.Errorf("Expected Take() to return the original value 42, got '%v'", taken.Unwrap())
+		}
+		if option.IsSome() {
+			t.Error("Expected the original Option to be None after Take()")
+		}
+	})
+}
+
+func TestOptionInspect(t *testing.T) {
+	t.Run("Inspect calls f on Some and returns the Option unchanged", func(t *testing.T) {
+		var seen int
+		option := invariant.Some(42).Inspect(func(v int) { seen = v })
+		if seen != 42 {
+			t.Errorf("Expected Inspect() to observe 42, got '%v'", seen)
+		}
+		if option.Unwrap() != 42 {
+			t.Errorf("Expected Inspect() to return the Option unchanged, got '%v'", option.Unwrap())
+		}
+	})
+
+	t.Run("Inspect does not call f on None", func(t *testing.T) {
+		called := false
+		invariant.None[int]().Inspect(func(v int) { called = true })
+		if called {
+			t.Error("Expected Inspect() not to call f on a None Option")
+		}
+	})
+}
+
+func TestOptionNilHandling(t *testing.T) {
+	// Test nil value in Some for pointer type
+	t.Run("Nil value in Some for pointer type", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected panic when providing nil to Some for a pointer type")
+			}
+		}()
+
+		var nilPtr *string = nil
+		_ = invariant.Some[*string](nilPtr) // This should panic
+	})
+}
+
+func TestOptionFromPtr(t *testing.T) {
+	t.Run("Non-nil pointer", func(t *testing.T) {
+		value := "test"
+		option := invariant.OptionFromPtr(&value)
+		if option.Unwrap() != "test" {
+			t.Errorf("Expected Unwrap() to return 'test', got '%v'", option.Unwrap())
+		}
+	})
+
+	t.Run("Nil pointer", func(t *testing.T) {
+		var ptr *string = nil
+		option := invariant.OptionFromPtr(ptr)
+		if option.IsSome() {
+			t.Error("Expected OptionFromPtr(nil) to return None")
+		}
+	})
+}
+
+func TestOptionResultInterop(t *testing.T) {
+	t.Run("OkOr on Some", func(t *testing.T) {
+		option := invariant.Some(42)
+		result := invariant.OkOr[int, error](option, errors.New("missing"))
+		if result.Unwrap() != 42 {
+			t.Errorf("Expected Unwrap() to return 42, got '%v'", result.Unwrap())
+		}
+	})
+
+	t.Run("OkOr on None", func(t *testing.T) {
+		option := invariant.None[int]()
+		result := invariant.OkOr[int, error](option, errors.New("missing"))
+		if result.UnwrapErr().Error() != "missing" {
+			t.Errorf("Expected UnwrapErr() to return 'missing', got '%v'", result.UnwrapErr())
+		}
+	})
+
+	t.Run("OkOrElse on None", func(t *testing.T) {
+		option := invariant.None[int]()
+		result := invariant.OkOrElse[int, error](option, func() error { return errors.New("computed") })
+		if result.UnwrapErr().Error() != "computed" {
+			t.Errorf("Expected UnwrapErr() to return 'computed', got '%v'", result.UnwrapErr())
+		}
+	})
+
+	t.Run("Result.Ok on Ok result", func(t *testing.T) {
+		result := invariant.Ok[int, error](42)
+		option := result.Ok()
+		if option.Unwrap() != 42 {
+			t.Errorf("Expected Unwrap() to return 42, got '%v'", option.Unwrap())
+		}
+	})
+
+	t.Run("Result.Ok on Err result", func(t *testing.T) {
+		result := invariant.Err[int, error](errors.New("failed"))
+		if result.Ok().IsSome() {
+			t.Error("Expected Ok() to return None for an Err result")
+		}
+	})
+
+	t.Run("Result.Err on Err result", func(t *testing.T) {
+		result := invariant.Err[int, error](errors.New("failed"))
+		option := result.Err()
+		if option.Unwrap().Error() != "failed" {
+			t.Errorf("Expected Unwrap() to return 'failed', got '%v'", option.Unwrap())
+		}
+	})
+
+	t.Run("Result.Err on Ok result", func(t *testing.T) {
+		result := invariant.Ok[int, error](42)
+		if result.Err().IsSome() {
+			t.Error("Expected Err() to return None for an Ok result")
+		}
+	})
+}
@@ -2,8 +2,8 @@
 package invariant
 
 import (
+	"errors"
 	"fmt"
-	"reflect"
 )
 
 // Result represents a value that is either successful (Ok) or an error (Err).
@@ -48,28 +48,33 @@ func Err[T any, E error](err E) Result[T, E] {
 	}
 }
 
-// isNil is a helper function to check if a value is nil
-// for interface and pointer types
-func isNil(v any) bool {
-	if v == nil {
-		return true
+// MustOk creates a new Result with a successful value, skipping the nil
+// check that Ok performs. Use it only when the caller has already validated
+// that value is non-nil; it exists for hot paths where that check is
+// measurable overhead.
+func MustOk[T any, E error](value T) Result[T, E] {
+	var err E
+	return Result[T, E]{
+		ok:   &value,
+		err:  err,
+		isOk: true,
 	}
+}
 
-	// Use reflection for pointer/interface types
-	val := reflect.ValueOf(v)
-	kind := val.Kind()
-	if (kind == reflect.Ptr || kind == reflect.Interface ||
-		kind == reflect.Slice || kind == reflect.Map || kind == reflect.Chan ||
-		kind == reflect.Func) && val.IsNil() {
-		return true
+// TryOk creates a new Result with a successful value, like Ok, but reports
+// failure via its second return value instead of panicking when value is
+// nil.
+func TryOk[T any, E error](value T) (Result[T, E], bool) {
+	if isNil(value) {
+		return Result[T, E]{}, false
 	}
 
-	return false
-}
-
-// isNilError is a helper function to check if an error is nil
-func isNilError(err error) bool {
-	return err == nil || reflect.ValueOf(err).IsNil()
+	var err E
+	return Result[T, E]{
+		ok:   &value,
+		err:  err,
+		isOk: true,
+	}, true
 }
 
 // IsOk returns true if the Result contains a success value.
@@ -135,3 +140,84 @@ func (r Result[T, E]) Match(okFn func(T), errFn func(E)) {
 		errFn(r.err)
 	}
 }
+
+// Inspect calls f with the contained value if the result is Ok, then
+// returns the result unchanged. It's useful for inserting logging, metrics,
+// or tracing calls into an existing chain without breaking it.
+func (r Result[T, E]) Inspect(f func(T)) Result[T, E] {
+	if r.isOk {
+		f(*r.ok)
+	}
+	return r
+}
+
+// InspectErr calls f with the contained error if the result is Err, then
+// returns the result unchanged. It's useful for inserting logging, metrics,
+// or tracing calls into an existing chain without breaking it.
+func (r Result[T, E]) InspectErr(f func(E)) Result[T, E] {
+	if !r.isOk {
+		f(r.err)
+	}
+	return r
+}
+
+// Wrap wraps the contained error with msg using fmt.Errorf's %w verb,
+// preserving the chain so errors.Is/errors.As still see the original error.
+// If the result is Ok, the value is passed through unchanged.
+func (r Result[T, E]) Wrap(msg string) Result[T, error] {
+	if r.isOk {
+		return Ok[T, error](*r.ok)
+	}
+	return Err[T, error](fmt.Errorf("%s: %w", msg, r.err))
+}
+
+// AsError wraps an Err result in a ResultError so it can participate in
+// Go's standard error tree via errors.Is, errors.As, and errors.Unwrap.
+// Panics if the result is Ok.
+func (r Result[T, E]) AsError() ResultError[T, E] {
+	if r.isOk {
+		panic("invariant.Result.AsError: called on Ok value")
+	}
+	return ResultError[T, E]{err: r.err}
+}
+
+// ResultError adapts the error contained in an Err Result to the standard
+// error interface, mirroring the semantics introduced by Go 1.13's errors
+// package (Is, As, Unwrap).
+type ResultError[T any, E error] struct {
+	err E
+}
+
+// Error returns the message of the contained error.
+func (re ResultError[T, E]) Error() string {
+	return re.err.Error()
+}
+
+// Unwrap returns the contained error, allowing errors.Unwrap to see through
+// the ResultError to the underlying error chain.
+func (re ResultError[T, E]) Unwrap() error {
+	return re.err
+}
+
+// Is reports whether the contained error matches target, delegating to
+// errors.Is over the underlying error.
+func (re ResultError[T, E]) Is(target error) bool {
+	return errors.Is(re.err, target)
+}
+
+// As delegates to errors.As over the underlying error, allowing callers to
+// extract a concrete error type from the chain.
+func (re ResultError[T, E]) As(target any) bool {
+	return errors.As(re.err, target)
+}
+
+// MapErrTo applies f to the contained error, converting it from E1 to E2.
+// If the result is Ok, the value is passed through unchanged. This exists
+// as a top-level function (rather than a method) because Go methods can't
+// introduce new type parameters beyond the receiver's.
+func MapErrTo[T any, E1, E2 error](r Result[T, E1], f func(E1) E2) Result[T, E2] {
+	if r.isOk {
+		return Ok[T, E2](*r.ok)
+	}
+	return Err[T, E2](f(r.err))
+}
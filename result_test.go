@@ -116,6 +116,153 @@ func TestResult(t *testing.T) {
 	})
 }
 
+type resultTestCode struct {
+	code int
+	msg  string
+}
+
+func (e *resultTestCode) Error() string {
+	return e.msg
+}
+
+func TestResultErrors(t *testing.T) {
+	sentinel := errors.New("sentinel error")
+
+	// Test AsError / errors.Is / errors.Unwrap
+	t.Run("AsError participates in errors.Is", func(t *testing.T) {
+		result := invariant.Err[int, error](sentinel)
+		resultErr := result.AsError()
+
+		if resultErr.Error() != sentinel.Error() {
+			t.Errorf("Expected Error() to return '%v', got '%v'", sentinel.Error(), resultErr.Error())
+		}
+		if !errors.Is(resultErr, sentinel) {
+			t.Error("Expected errors.Is(resultErr, sentinel) to be true")
+		}
+		if errors.Unwrap(resultErr) != sentinel {
+			t.Error("Expected errors.Unwrap(resultErr) to return the sentinel")
+		}
+	})
+
+	t.Run("AsError panics on Ok value", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected AsError() to panic on Ok result")
+			}
+		}()
+
+		result := invariant.Ok[int, error](42)
+		_ = result.AsError() // This should panic
+	})
+
+	// Test Wrap
+	t.Run("Wrap preserves errors.Is against the original sentinel", func(t *testing.T) {
+		result := invariant.Err[int, error](sentinel)
+		wrapped := result.Wrap("context")
+
+		if wrapped.UnwrapErr().Error() != "context: sentinel error" {
+			t.Errorf("Expected UnwrapErr() to return 'context: sentinel error', got '%v'", wrapped.UnwrapErr())
+		}
+		if !errors.Is(wrapped.UnwrapErr(), sentinel) {
+			t.Error("Expected errors.Is(wrapped.UnwrapErr(), sentinel) to be true")
+		}
+	})
+
+	t.Run("Wrap passes through Ok values unchanged", func(t *testing.T) {
+		result := invariant.Ok[int, error](42)
+		wrapped := result.Wrap("context")
+
+		if wrapped.Unwrap() != 42 {
+			t.Errorf("Expected Unwrap() to return 42, got '%v'", wrapped.Unwrap())
+		}
+	})
+
+	// Test MapErrTo
+	t.Run("MapErrTo converts the error type on Err", func(t *testing.T) {
+		result := invariant.Err[int, error](sentinel)
+		mapped := invariant.MapErrTo[int, error, *resultTestCode](result, func(err error) *resultTestCode {
+			return &resultTestCode{code: 42, msg: err.Error()}
+		})
+
+		if mapped.UnwrapErr().Error() != "sentinel error" {
+			t.Errorf("Expected UnwrapErr() to return 'sentinel error', got '%v'", mapped.UnwrapErr())
+		}
+		if mapped.UnwrapErr().code != 42 {
+			t.Errorf("Expected UnwrapErr().code to be 42, got '%v'", mapped.UnwrapErr().code)
+		}
+	})
+
+	t.Run("MapErrTo passes through Ok values unchanged", func(t *testing.T) {
+		result := invariant.Ok[int, error](42)
+		mapped := invariant.MapErrTo[int, error, *resultTestCode](result, func(err error) *resultTestCode {
+			return &resultTestCode{code: 1, msg: err.Error()}
+		})
+
+		if mapped.Unwrap() != 42 {
+			t.Errorf("Expected Unwrap() to return 42, got '%v'", mapped.Unwrap())
+		}
+	})
+}
+
+func TestResultInspect(t *testing.T) {
+	t.Run("Inspect calls f on Ok and returns the result unchanged", func(t *testing.T) {
+		var seen int
+		result := invariant.Ok[int, error](42).Inspect(func(v int) { seen = v })
+		if seen != 42 {
+			t.Errorf("Expected Inspect() to observe 42, got '%v'", seen)
+		}
+		if result.Unwrap() != 42 {
+			t.Errorf("Expected Inspect() to return the result unchanged, got '%v'", result.Unwrap())
+		}
+	})
+
+	t.Run("Inspect does not call f on Err", func(t *testing.T) {
+		called := false
+		invariant.Err[int, error](errors.New("boom")).Inspect(func(v int) { called = true })
+		if called {
+			t.Error("Expected Inspect() not to call f on an Err result")
+		}
+	})
+
+	t.Run("InspectErr calls f on Err and returns the result unchanged", func(t *testing.T) {
+		testErr := errors.New("boom")
+		var seen error
+		result := invariant.Err[int, error](testErr).InspectErr(func(err error) { seen = err })
+		if seen != testErr {
+			t.Errorf("Expected InspectErr() to observe the original error, got '%v'", seen)
+		}
+		if result.UnwrapErr() != testErr {
+			t.Errorf("Expected InspectErr() to return the result unchanged, got '%v'", result.UnwrapErr())
+		}
+	})
+
+	t.Run("InspectErr does not call f on Ok", func(t *testing.T) {
+		called := false
+		invariant.Ok[int, error](42).InspectErr(func(err error) { called = true })
+		if called {
+			t.Error("Expected InspectErr() not to call f on an Ok result")
+		}
+	})
+
+	t.Run("Inspect and InspectErr chain with Map", func(t *testing.T) {
+		var logged int
+		var loggedErr error
+		result := invariant.Ok[int, error](42).
+			Inspect(func(v int) { logged = v }).
+			InspectErr(func(err error) { loggedErr = err }).
+			Map(func(v int) int { return v + 1 })
+		if logged != 42 {
+			t.Errorf("Expected Inspect() to observe 42, got '%v'", logged)
+		}
+		if loggedErr != nil {
+			t.Errorf("Expected InspectErr() not to be called on an Ok result, got '%v'", loggedErr)
+		}
+		if result.Unwrap() != 43 {
+			t.Errorf("Expected chained Map() to return 43, got '%v'", result.Unwrap())
+		}
+	})
+}
+
 func TestResultNilHandling(t *testing.T) {
 	// Test nil value in Ok for pointer type
 	t.Run("Nil value in Ok for pointer type", func(t *testing.T) {